@@ -0,0 +1,193 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"math/rand"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// ErrCacheMiss is returned by Cache.Get when the key isn't present (or has
+// expired), distinguishing a miss from a backend error.
+var ErrCacheMiss = errors.New("cache: miss")
+
+const (
+	defaultTTL          = time.Hour
+	negativeTTL         = 30 * time.Second
+	negativeCacheMarker = "__NOT_FOUND__"
+	ttlJitterFraction   = 0.10
+	defaultLRUCapacity  = 1000
+)
+
+// Cache abstracts the key/value store backing BookRepositoryImpl so the
+// backend (Redis, in-memory) can be swapped without touching repository
+// logic.
+type Cache interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value string, ttl time.Duration) error
+	Del(ctx context.Context, keys ...string) error
+	Ping(ctx context.Context) error
+	Close() error
+}
+
+// jitteredTTL spreads out expiry by +/- ttlJitterFraction so keys written
+// around the same time (e.g. books:all after a bulk import) don't all
+// expire in the same instant and stampede the DB.
+func jitteredTTL(base time.Duration) time.Duration {
+	spread := float64(base) * ttlJitterFraction
+	offset := (rand.Float64()*2 - 1) * spread
+	return base + time.Duration(offset)
+}
+
+// redisCache implements Cache on top of a *redis.Client.
+type redisCache struct {
+	client *redis.Client
+}
+
+func newRedisCache(addr string) *redisCache {
+	return &redisCache{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+func (c *redisCache) Get(ctx context.Context, key string) (string, error) {
+	val, err := c.client.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return "", ErrCacheMiss
+	}
+	return val, err
+}
+
+func (c *redisCache) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	return c.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (c *redisCache) Del(ctx context.Context, keys ...string) error {
+	return c.client.Del(ctx, keys...).Err()
+}
+
+func (c *redisCache) Ping(ctx context.Context) error {
+	return c.client.Ping(ctx).Err()
+}
+
+func (c *redisCache) Close() error {
+	return c.client.Close()
+}
+
+// lruEntry is a single slot in lruCache's linked list.
+type lruEntry struct {
+	key       string
+	value     string
+	expiresAt time.Time
+}
+
+// lruCache is an in-memory, size-bounded Cache for deployments that don't
+// want a Redis dependency. Eviction is least-recently-used; entries also
+// expire on their own TTL.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+func newLRUCache(capacity int) *lruCache {
+	if capacity <= 0 {
+		capacity = defaultLRUCapacity
+	}
+	return &lruCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (c *lruCache) Get(ctx context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return "", ErrCacheMiss
+	}
+	entry := el.Value.(*lruEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.ll.Remove(el)
+		delete(c.items, key)
+		return "", ErrCacheMiss
+	}
+	c.ll.MoveToFront(el)
+	return entry.value, nil
+}
+
+func (c *lruCache) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = time.Now().Add(ttl)
+		c.ll.MoveToFront(el)
+		return nil
+	}
+
+	el := c.ll.PushFront(&lruEntry{key: key, value: value, expiresAt: time.Now().Add(ttl)})
+	c.items[key] = el
+
+	if c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		if oldest != nil {
+			c.ll.Remove(oldest)
+			delete(c.items, oldest.Value.(*lruEntry).key)
+		}
+	}
+	return nil
+}
+
+func (c *lruCache) Del(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if el, ok := c.items[key]; ok {
+			c.ll.Remove(el)
+			delete(c.items, key)
+		}
+	}
+	return nil
+}
+
+func (c *lruCache) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (c *lruCache) Close() error {
+	return nil
+}
+
+// newCache builds the Cache backend selected by CACHE_BACKEND ("redis",
+// the default, or "memory"). REDIS_URL and CACHE_LRU_SIZE configure their
+// respective backends.
+func newCache() Cache {
+	switch os.Getenv("CACHE_BACKEND") {
+	case "memory":
+		capacity := defaultLRUCapacity
+		if v := os.Getenv("CACHE_LRU_SIZE"); v != "" {
+			if n, err := strconv.Atoi(v); err == nil {
+				capacity = n
+			}
+		}
+		return newLRUCache(capacity)
+	default:
+		redisURL = os.Getenv("REDIS_URL")
+		if redisURL == "" {
+			redisURL = "redis-cache:6379"
+		}
+		return newRedisCache(redisURL)
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLRUCache_EvictsOldestOnOverflow(t *testing.T) {
+	ctx := context.Background()
+	c := newLRUCache(2)
+
+	c.Set(ctx, "a", "1", time.Hour)
+	c.Set(ctx, "b", "2", time.Hour)
+	c.Set(ctx, "c", "3", time.Hour) // capacity 2: evicts "a", the least recently used
+
+	if _, err := c.Get(ctx, "a"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("Get(a) = %v, want ErrCacheMiss", err)
+	}
+	if v, err := c.Get(ctx, "b"); err != nil || v != "2" {
+		t.Fatalf("Get(b) = %q, %v, want \"2\", nil", v, err)
+	}
+	if v, err := c.Get(ctx, "c"); err != nil || v != "3" {
+		t.Fatalf("Get(c) = %q, %v, want \"3\", nil", v, err)
+	}
+}
+
+func TestLRUCache_GetRefreshesRecency(t *testing.T) {
+	ctx := context.Background()
+	c := newLRUCache(2)
+
+	c.Set(ctx, "a", "1", time.Hour)
+	c.Set(ctx, "b", "2", time.Hour)
+	c.Get(ctx, "a")                 // "a" is now more recently used than "b"
+	c.Set(ctx, "c", "3", time.Hour) // capacity 2: evicts "b", not "a"
+
+	if _, err := c.Get(ctx, "b"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("Get(b) = %v, want ErrCacheMiss", err)
+	}
+	if v, err := c.Get(ctx, "a"); err != nil || v != "1" {
+		t.Fatalf("Get(a) = %q, %v, want \"1\", nil", v, err)
+	}
+}
+
+func TestLRUCache_GetMissAfterExpiry(t *testing.T) {
+	ctx := context.Background()
+	c := newLRUCache(defaultLRUCapacity)
+
+	c.Set(ctx, "a", "1", time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	if _, err := c.Get(ctx, "a"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("Get(a) after expiry = %v, want ErrCacheMiss", err)
+	}
+}
+
+func TestLRUCache_Del(t *testing.T) {
+	ctx := context.Background()
+	c := newLRUCache(defaultLRUCapacity)
+
+	c.Set(ctx, "a", "1", time.Hour)
+	c.Del(ctx, "a")
+
+	if _, err := c.Get(ctx, "a"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("Get(a) after Del = %v, want ErrCacheMiss", err)
+	}
+}
+
+func TestJitteredTTL_WithinBounds(t *testing.T) {
+	base := time.Minute
+	spread := time.Duration(float64(base) * ttlJitterFraction)
+
+	for i := 0; i < 100; i++ {
+		d := jitteredTTL(base)
+		if d < base-spread || d > base+spread {
+			t.Fatalf("jitteredTTL(%v) = %v, want within +/- %v", base, d, spread)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Event types recorded for every book mutation.
+const (
+	EventTypeCreated = "created"
+	EventTypeUpdated = "updated"
+	EventTypeDeleted = "deleted"
+)
+
+// BookEvent is an audit-log row written in the same transaction as the
+// book mutation it describes (the transactional outbox pattern), and
+// later published to EventSink by the background dispatcher.
+type BookEvent struct {
+	ID          int64           `json:"id"`
+	BookID      string          `json:"book_id"`
+	EventType   string          `json:"event_type"`
+	Payload     json.RawMessage `json:"payload"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	PublishedAt *time.Time      `json:"published_at,omitempty"`
+}
+
+// recordEvent inserts an event row as part of the caller's transaction so
+// a book mutation and its audit record commit or roll back together.
+func (r *BookRepositoryImpl) recordEvent(ctx context.Context, tx *sql.Tx, bookID, eventType string, payload interface{}) error {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO book_events (book_id, event_type, payload)
+        VALUES ($1, $2, $3)`
+
+	_, err = tx.ExecContext(ctx, query, bookID, eventType, payloadJSON)
+	return err
+}
+
+func (r *BookRepositoryImpl) ListEventsForBook(ctx context.Context, bookID string) ([]BookEvent, error) {
+	query := `
+        SELECT id, book_id, event_type, payload, occurred_at, published_at
+        FROM book_events
+        WHERE book_id = $1
+        ORDER BY occurred_at ASC`
+
+	return r.queryEvents(ctx, query, bookID)
+}
+
+func (r *BookRepositoryImpl) ListEventsSince(ctx context.Context, since time.Time) ([]BookEvent, error) {
+	query := `
+        SELECT id, book_id, event_type, payload, occurred_at, published_at
+        FROM book_events
+        WHERE occurred_at > $1
+        ORDER BY occurred_at ASC`
+
+	return r.queryEvents(ctx, query, since)
+}
+
+func (r *BookRepositoryImpl) queryEvents(ctx context.Context, query string, arg interface{}) ([]BookEvent, error) {
+	rows, err := r.db.QueryContext(ctx, query, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []BookEvent
+	for rows.Next() {
+		var event BookEvent
+		if err := rows.Scan(
+			&event.ID,
+			&event.BookID,
+			&event.EventType,
+			&event.Payload,
+			&event.OccurredAt,
+			&event.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// unpublishedEvents fetches a batch of events the dispatcher hasn't
+// pushed to the sink yet.
+func (r *BookRepositoryImpl) unpublishedEvents(ctx context.Context, limit int) ([]BookEvent, error) {
+	query := `
+        SELECT id, book_id, event_type, payload, occurred_at, published_at
+        FROM book_events
+        WHERE published_at IS NULL
+        ORDER BY id ASC
+        LIMIT $1`
+
+	return r.queryEvents(ctx, query, limit)
+}
+
+func (r *BookRepositoryImpl) markEventPublished(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE book_events SET published_at = now() WHERE id = $1`, id)
+	return err
+}
+
+// EventSink is where published BookEvents end up. It's pluggable so
+// tests and memory-cache deployments aren't forced to depend on Redis.
+type EventSink interface {
+	Publish(ctx context.Context, event BookEvent) error
+}
+
+// redisStreamSink publishes events onto a Redis stream via XADD.
+type redisStreamSink struct {
+	client *redis.Client
+	stream string
+}
+
+func newRedisStreamSink(addr, stream string) *redisStreamSink {
+	return &redisStreamSink{
+		client: redis.NewClient(&redis.Options{Addr: addr}),
+		stream: stream,
+	}
+}
+
+func (s *redisStreamSink) Publish(ctx context.Context, event BookEvent) error {
+	_, err := s.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: s.stream,
+		Values: map[string]interface{}{
+			"id":          event.ID,
+			"book_id":     event.BookID,
+			"event_type":  event.EventType,
+			"payload":     string(event.Payload),
+			"occurred_at": event.OccurredAt.Format(time.RFC3339Nano),
+		},
+	}).Result()
+	return err
+}
+
+// logSink just logs published events; newEventSink selects it when
+// EVENT_SINK=log. CACHE_BACKEND does not affect which sink is used.
+type logSink struct{}
+
+func (logSink) Publish(ctx context.Context, event BookEvent) error {
+	log.Printf("book event published: id=%d book_id=%s type=%s", event.ID, event.BookID, event.EventType)
+	return nil
+}
+
+// newEventSink builds the sink selected by EVENT_SINK ("redis", the
+// default, or "log"). EVENT_STREAM names the Redis stream key.
+func newEventSink() EventSink {
+	switch os.Getenv("EVENT_SINK") {
+	case "log":
+		return logSink{}
+	default:
+		addr := os.Getenv("REDIS_URL")
+		if addr == "" {
+			addr = "redis-cache:6379"
+		}
+		stream := os.Getenv("EVENT_STREAM")
+		if stream == "" {
+			stream = "books.events"
+		}
+		return newRedisStreamSink(addr, stream)
+	}
+}
+
+// EventDispatcher polls for unpublished outbox rows and pushes them to
+// an EventSink, retrying with backoff before leaving stragglers for the
+// next poll.
+type EventDispatcher struct {
+	repo           *BookRepositoryImpl
+	sink           EventSink
+	pollInterval   time.Duration
+	batchSize      int
+	maxRetries     int
+	initialBackoff time.Duration
+}
+
+func NewEventDispatcher(repo *BookRepositoryImpl, sink EventSink) *EventDispatcher {
+	return &EventDispatcher{
+		repo:           repo,
+		sink:           sink,
+		pollInterval:   2 * time.Second,
+		batchSize:      50,
+		maxRetries:     3,
+		initialBackoff: 100 * time.Millisecond,
+	}
+}
+
+// Run polls until ctx is cancelled. It's meant to be started as a
+// goroutine from main and stopped via the same context used for
+// shutdown.
+func (d *EventDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.dispatchOnce(ctx)
+		}
+	}
+}
+
+func (d *EventDispatcher) dispatchOnce(ctx context.Context) {
+	events, err := d.repo.unpublishedEvents(ctx, d.batchSize)
+	if err != nil {
+		log.Printf("event dispatcher: failed to load unpublished events: %v", err)
+		return
+	}
+
+	for _, event := range events {
+		if err := d.publishWithRetry(ctx, event); err != nil {
+			log.Printf("event dispatcher: giving up on event %d until next poll: %v", event.ID, err)
+			continue
+		}
+		if err := d.repo.markEventPublished(ctx, event.ID); err != nil {
+			log.Printf("event dispatcher: failed to mark event %d published: %v", event.ID, err)
+		}
+	}
+}
+
+func (d *EventDispatcher) publishWithRetry(ctx context.Context, event BookEvent) error {
+	backoff := d.initialBackoff
+	var err error
+	for attempt := 0; attempt <= d.maxRetries; attempt++ {
+		if err = d.sink.Publish(ctx, event); err == nil {
+			return nil
+		}
+		if attempt == d.maxRetries {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	return fmt.Errorf("publish event %d: %w", event.ID, err)
+}
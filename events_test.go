@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeSink struct {
+	failures int
+	calls    int
+}
+
+func (s *fakeSink) Publish(ctx context.Context, event BookEvent) error {
+	s.calls++
+	if s.calls <= s.failures {
+		return errors.New("sink unavailable")
+	}
+	return nil
+}
+
+func TestEventDispatcher_PublishWithRetry_SucceedsAfterTransientFailures(t *testing.T) {
+	sink := &fakeSink{failures: 2}
+	d := &EventDispatcher{sink: sink, maxRetries: 3, initialBackoff: time.Millisecond}
+
+	if err := d.publishWithRetry(context.Background(), BookEvent{ID: 1}); err != nil {
+		t.Fatalf("publishWithRetry() = %v, want nil", err)
+	}
+	if sink.calls != 3 {
+		t.Fatalf("sink.calls = %d, want 3", sink.calls)
+	}
+}
+
+func TestEventDispatcher_PublishWithRetry_GivesUpAfterMaxRetries(t *testing.T) {
+	sink := &fakeSink{failures: 100}
+	d := &EventDispatcher{sink: sink, maxRetries: 3, initialBackoff: time.Millisecond}
+
+	err := d.publishWithRetry(context.Background(), BookEvent{ID: 42})
+	if err == nil {
+		t.Fatal("publishWithRetry() = nil, want an error")
+	}
+	if !strings.Contains(err.Error(), "42") {
+		t.Fatalf("publishWithRetry() error = %q, want it to reference the event id", err)
+	}
+	// maxRetries=3 means one initial attempt plus 3 retries.
+	if sink.calls != 4 {
+		t.Fatalf("sink.calls = %d, want 4", sink.calls)
+	}
+}
+
+func TestEventDispatcher_PublishWithRetry_StopsOnContextCancel(t *testing.T) {
+	sink := &fakeSink{failures: 100}
+	d := &EventDispatcher{sink: sink, maxRetries: 5, initialBackoff: 50 * time.Millisecond}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := d.publishWithRetry(ctx, BookEvent{ID: 7})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("publishWithRetry() = %v, want context.Canceled", err)
+	}
+}
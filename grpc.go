@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nimbletactician/book-service/pb"
+)
+
+// grpcBookServer adapts *BookService to the generated BookServiceServer
+// interface so gRPC clients share the same validation, caching, and DB
+// code as the HTTP handlers.
+type grpcBookServer struct {
+	pb.UnimplementedBookServiceServer
+	service *BookService
+}
+
+func newGRPCBookServer(service *BookService) *grpcBookServer {
+	return &grpcBookServer{service: service}
+}
+
+func toProtoBook(book *Book) *pb.Book {
+	return &pb.Book{
+		Id:        book.ID,
+		Title:     book.Title,
+		Rating:    book.Rating,
+		CreatedAt: timestamppb.New(book.CreatedAt),
+	}
+}
+
+func (g *grpcBookServer) CreateBook(ctx context.Context, req *pb.CreateBookRequest) (*pb.Book, error) {
+	book := &Book{ID: req.GetId(), Title: req.GetTitle(), Rating: req.GetRating()}
+	if err := g.service.CreateBook(ctx, book); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return toProtoBook(book), nil
+}
+
+func (g *grpcBookServer) GetBook(ctx context.Context, req *pb.GetBookRequest) (*pb.Book, error) {
+	book, err := g.service.GetBook(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	if book == nil {
+		return nil, status.Errorf(codes.NotFound, "book not found")
+	}
+	return toProtoBook(book), nil
+}
+
+func (g *grpcBookServer) ListBooks(ctx context.Context, req *pb.ListBooksRequest) (*pb.ListBooksResponse, error) {
+	books, err := g.service.ListBooks(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	resp := &pb.ListBooksResponse{Books: make([]*pb.Book, len(books))}
+	for i := range books {
+		resp.Books[i] = toProtoBook(&books[i])
+	}
+	return resp, nil
+}
+
+func (g *grpcBookServer) UpdateBook(ctx context.Context, req *pb.UpdateBookRequest) (*pb.Book, error) {
+	book := &Book{ID: req.GetId(), Title: req.GetTitle(), Rating: req.GetRating()}
+	if err := g.service.UpdateBook(ctx, book); err != nil {
+		if err == ErrBookNotFound {
+			return nil, status.Errorf(codes.NotFound, "book not found")
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return toProtoBook(book), nil
+}
+
+func (g *grpcBookServer) DeleteBook(ctx context.Context, req *pb.DeleteBookRequest) (*pb.DeleteBookResponse, error) {
+	if err := g.service.DeleteBook(ctx, req.GetId()); err != nil {
+		if err == ErrBookNotFound {
+			return nil, status.Errorf(codes.NotFound, "book not found")
+		}
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return &pb.DeleteBookResponse{}, nil
+}
+
+// grpcHealthServer implements grpc_health_v1.HealthServer on top of the
+// same DB/Redis checks used by ReadyHandler, so gRPC clients and
+// Kubernetes probes observe the same readiness signal.
+type grpcHealthServer struct {
+	healthpb.UnimplementedHealthServer
+	repo *BookRepositoryImpl
+}
+
+func newGRPCHealthServer(repo *BookRepositoryImpl) *grpcHealthServer {
+	return &grpcHealthServer{repo: repo}
+}
+
+func (h *grpcHealthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if !isReady.Load() {
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	if err := h.repo.CheckDBHealth(ctx); err != nil {
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	if err := h.repo.CheckCacheHealth(ctx); err != nil {
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+func (h *grpcHealthServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	return status.Error(codes.Unimplemented, "watch is not supported")
+}
@@ -6,21 +6,35 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
-	"github.com/go-redis/redis/v8"
 	_ "github.com/lib/pq"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/nimbletactician/book-service/metrics"
+	"github.com/nimbletactician/book-service/pb"
 )
 
 var (
 	redisURL string
-	isReady  = false // Flag to track readiness
+	isReady  atomic.Bool // flips true once startup checks pass, false again during shutdown
 )
 
+// ErrBookNotFound is returned by the repository when an Update or Delete
+// targets an id that doesn't exist.
+var ErrBookNotFound = fmt.Errorf("book not found")
+
 // Domain types
 type Book struct {
 	ID        string    `json:"id"`
@@ -34,12 +48,16 @@ type BookRepository interface {
 	Create(ctx context.Context, book *Book) error
 	GetByID(ctx context.Context, id string) (*Book, error)
 	List(ctx context.Context) ([]Book, error)
+	Update(ctx context.Context, book *Book) error
+	Delete(ctx context.Context, id string) error
 }
 
 // Repository implementation
 type BookRepositoryImpl struct {
-	db    *sql.DB
-	cache *redis.Client
+	db      *sql.DB
+	cache   Cache
+	sf      singleflight.Group
+	metrics metrics.Cache
 }
 
 // Add these new types and variables at the top level
@@ -53,33 +71,32 @@ func (r *BookRepositoryImpl) CheckDBHealth(ctx context.Context) error {
 	return r.db.PingContext(ctx)
 }
 
-func (r *BookRepositoryImpl) CheckRedisHealth(ctx context.Context) error {
-	return r.cache.Ping(ctx).Err()
+func (r *BookRepositoryImpl) CheckCacheHealth(ctx context.Context) error {
+	return r.cache.Ping(ctx)
 }
 
 func NewBookRepository(db *sql.DB) *BookRepositoryImpl {
-	redisURL = os.Getenv("REDIS_URL")
-	if redisURL == "" {
-		redisURL = "redis-cache:6379"
-	}
-
-	cache := redis.NewClient(&redis.Options{
-		Addr: redisURL,
-	})
-
 	return &BookRepositoryImpl{
-		db:    db,
-		cache: cache,
+		db:      db,
+		cache:   newCache(),
+		metrics: metrics.NewCache(),
 	}
 }
 
 func (r *BookRepositoryImpl) Create(ctx context.Context, book *Book) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	query := `
         INSERT INTO books (id, title, rating, created_at)
         VALUES ($1, $2, $3, $4)
         RETURNING created_at`
 
-	err := r.db.QueryRowContext(
+	queryStart := time.Now()
+	err = tx.QueryRowContext(
 		ctx,
 		query,
 		book.ID,
@@ -87,15 +104,23 @@ func (r *BookRepositoryImpl) Create(ctx context.Context, book *Book) error {
 		book.Rating,
 		time.Now(),
 	).Scan(&book.CreatedAt)
-
+	observeDBQuery(ctx, "create", queryStart)
 	if err != nil {
 		return err
 	}
 
+	if err := r.recordEvent(ctx, tx, book.ID, EventTypeCreated, book); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
 	// Store in cache
 	cacheKey := fmt.Sprintf("book:%s", book.ID)
 	if bookJSON, err := json.Marshal(book); err == nil {
-		r.cache.Set(ctx, cacheKey, bookJSON, time.Hour)
+		r.cache.Set(ctx, cacheKey, string(bookJSON), jitteredTTL(defaultTTL))
 	}
 
 	// Invalidate list cache
@@ -105,85 +130,204 @@ func (r *BookRepositoryImpl) Create(ctx context.Context, book *Book) error {
 }
 
 func (r *BookRepositoryImpl) GetByID(ctx context.Context, id string) (*Book, error) {
-	// Try cache first
 	cacheKey := fmt.Sprintf("book:%s", id)
-	if cached, err := r.cache.Get(ctx, cacheKey).Result(); err == nil {
+
+	cacheStart := time.Now()
+	cached, cacheErr := r.cache.Get(ctx, cacheKey)
+	addCacheLatency(ctx, time.Since(cacheStart))
+
+	if cacheErr == nil {
+		r.metrics.Hit("get_by_id")
+		if cached == negativeCacheMarker {
+			return nil, nil
+		}
 		var book Book
 		if err := json.Unmarshal([]byte(cached), &book); err == nil {
 			return &book, nil
 		}
+	} else {
+		r.metrics.Miss("get_by_id")
 	}
 
-	// If not in cache, get from DB
-	book := &Book{}
-	query := `
+	// Collapse concurrent misses for the same id into a single DB query.
+	v, err, _ := r.sf.Do(cacheKey, func() (interface{}, error) {
+		book := &Book{}
+		query := `
         SELECT id, title, rating, created_at
         FROM books
         WHERE id = $1`
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&book.ID,
-		&book.Title,
-		&book.Rating,
-		&book.CreatedAt,
-	)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+		queryStart := time.Now()
+		err := r.db.QueryRowContext(ctx, query, id).Scan(
+			&book.ID,
+			&book.Title,
+			&book.Rating,
+			&book.CreatedAt,
+		)
+		observeDBQuery(ctx, "get_by_id", queryStart)
+		if err == sql.ErrNoRows {
+			r.cache.Set(ctx, cacheKey, negativeCacheMarker, negativeTTL)
+			return (*Book)(nil), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if bookJSON, err := json.Marshal(book); err == nil {
+			r.cache.Set(ctx, cacheKey, string(bookJSON), jitteredTTL(defaultTTL))
+		}
+
+		return book, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	// Store in cache
-	if bookJSON, err := json.Marshal(book); err == nil {
-		r.cache.Set(ctx, cacheKey, bookJSON, time.Hour)
-	}
-
-	return book, nil
+	return v.(*Book), nil
 }
 
 func (r *BookRepositoryImpl) List(ctx context.Context) ([]Book, error) {
-	// Try cache first
-	cacheKey := "books:all"
-	if cached, err := r.cache.Get(ctx, cacheKey).Result(); err == nil {
+	const cacheKey = "books:all"
+
+	cacheStart := time.Now()
+	cached, cacheErr := r.cache.Get(ctx, cacheKey)
+	addCacheLatency(ctx, time.Since(cacheStart))
+
+	if cacheErr == nil {
 		var books []Book
 		if err := json.Unmarshal([]byte(cached), &books); err == nil {
+			r.metrics.Hit("list")
 			return books, nil
 		}
 	}
+	r.metrics.Miss("list")
 
-	// If not in cache, get from DB
-	query := `
+	// Collapse concurrent misses into a single DB query.
+	v, err, _ := r.sf.Do(cacheKey, func() (interface{}, error) {
+		query := `
         SELECT id, title, rating, created_at
         FROM books
         ORDER BY created_at DESC`
 
-	rows, err := r.db.QueryContext(ctx, query)
+		queryStart := time.Now()
+		rows, err := r.db.QueryContext(ctx, query)
+		observeDBQuery(ctx, "list", queryStart)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var books []Book
+		for rows.Next() {
+			var book Book
+			if err := rows.Scan(
+				&book.ID,
+				&book.Title,
+				&book.Rating,
+				&book.CreatedAt,
+			); err != nil {
+				return nil, err
+			}
+			books = append(books, book)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+
+		if booksJSON, err := json.Marshal(books); err == nil {
+			r.cache.Set(ctx, cacheKey, string(booksJSON), jitteredTTL(defaultTTL))
+		}
+
+		return books, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	return v.([]Book), nil
+}
 
-	var books []Book
-	for rows.Next() {
-		var book Book
-		if err := rows.Scan(
-			&book.ID,
-			&book.Title,
-			&book.Rating,
-			&book.CreatedAt,
-		); err != nil {
-			return nil, err
-		}
-		books = append(books, book)
+func (r *BookRepositoryImpl) Update(ctx context.Context, book *Book) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
 	}
+	defer tx.Rollback()
 
-	// Store in cache
-	if booksJSON, err := json.Marshal(books); err == nil {
-		r.cache.Set(ctx, cacheKey, booksJSON, time.Hour)
+	query := `
+        UPDATE books
+        SET title = $2, rating = $3
+        WHERE id = $1
+        RETURNING created_at`
+
+	queryStart := time.Now()
+	err = tx.QueryRowContext(
+		ctx,
+		query,
+		book.ID,
+		book.Title,
+		book.Rating,
+	).Scan(&book.CreatedAt)
+	observeDBQuery(ctx, "update", queryStart)
+
+	if err == sql.ErrNoRows {
+		return ErrBookNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := r.recordEvent(ctx, tx, book.ID, EventTypeUpdated, book); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	// Refresh cache and invalidate the list
+	cacheKey := fmt.Sprintf("book:%s", book.ID)
+	if bookJSON, err := json.Marshal(book); err == nil {
+		r.cache.Set(ctx, cacheKey, string(bookJSON), jitteredTTL(defaultTTL))
+	}
+	r.cache.Del(ctx, "books:all")
+
+	return nil
+}
+
+func (r *BookRepositoryImpl) Delete(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	queryStart := time.Now()
+	result, err := tx.ExecContext(ctx, `DELETE FROM books WHERE id = $1`, id)
+	observeDBQuery(ctx, "delete", queryStart)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrBookNotFound
+	}
+
+	if err := r.recordEvent(ctx, tx, id, EventTypeDeleted, map[string]string{"id": id}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
 	}
 
-	return books, nil
+	cacheKey := fmt.Sprintf("book:%s", id)
+	r.cache.Del(ctx, cacheKey)
+	r.cache.Del(ctx, "books:all")
+
+	return nil
 }
 
 // Service layer
@@ -195,13 +339,20 @@ func NewBookService(repo BookRepository) *BookService {
 	return &BookService{repo: repo}
 }
 
-func (s *BookService) CreateBook(ctx context.Context, book *Book) error {
+func validateBook(book *Book) error {
 	if book.Title == "" {
 		return fmt.Errorf("title is required")
 	}
 	if book.Rating < 0 || book.Rating > 5 {
 		return fmt.Errorf("rating must be between 0 and 5")
 	}
+	return nil
+}
+
+func (s *BookService) CreateBook(ctx context.Context, book *Book) error {
+	if err := validateBook(book); err != nil {
+		return err
+	}
 	return s.repo.Create(ctx, book)
 }
 
@@ -213,6 +364,17 @@ func (s *BookService) ListBooks(ctx context.Context) ([]Book, error) {
 	return s.repo.List(ctx)
 }
 
+func (s *BookService) UpdateBook(ctx context.Context, book *Book) error {
+	if err := validateBook(book); err != nil {
+		return err
+	}
+	return s.repo.Update(ctx, book)
+}
+
+func (s *BookService) DeleteBook(ctx context.Context, id string) error {
+	return s.repo.Delete(ctx, id)
+}
+
 // HTTP Handler
 type BookHandler struct {
 	service *BookService
@@ -223,11 +385,6 @@ func NewBookHandler(service *BookService) *BookHandler {
 }
 
 func (h *BookHandler) CreateBook(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
 	var book Book
 	if err := json.NewDecoder(r.Body).Decode(&book); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
@@ -244,23 +401,18 @@ func (h *BookHandler) CreateBook(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(book)
 }
 
-func (h *BookHandler) GetBook(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+func (h *BookHandler) ListBooks(w http.ResponseWriter, r *http.Request) {
+	books, err := h.service.ListBooks(r.Context())
+	if err != nil {
+		http.Error(w, "Failed to fetch books", http.StatusInternalServerError)
 		return
 	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(books)
+}
 
-	id := r.URL.Query().Get("id")
-	if id == "" {
-		books, err := h.service.ListBooks(r.Context())
-		if err != nil {
-			http.Error(w, "Failed to fetch books", http.StatusInternalServerError)
-			return
-		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(books)
-		return
-	}
+func (h *BookHandler) GetBook(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
 
 	book, err := h.service.GetBook(r.Context(), id)
 	if err != nil {
@@ -276,14 +428,118 @@ func (h *BookHandler) GetBook(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(book)
 }
 
-// Middleware
-func loggingMiddleware(next http.HandlerFunc) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		start := time.Now()
-		log.Printf("Started %s %s", r.Method, r.URL.Path)
-		next(w, r)
-		log.Printf("Completed %s %s in %v", r.Method, r.URL.Path, time.Since(start))
+func (h *BookHandler) UpdateBook(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	var book Book
+	if err := json.NewDecoder(r.Body).Decode(&book); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	book.ID = id
+
+	if err := h.service.UpdateBook(r.Context(), &book); err != nil {
+		if err == ErrBookNotFound {
+			http.Error(w, "Book not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(book)
+}
+
+func (h *BookHandler) DeleteBook(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	if err := h.service.DeleteBook(r.Context(), id); err != nil {
+		if err == ErrBookNotFound {
+			http.Error(w, "Book not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to delete book", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *BookHandler) ListBookEvents(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	repo, ok := h.service.repo.(*BookRepositoryImpl)
+	if !ok {
+		http.Error(w, "Repository type assertion failed", http.StatusInternalServerError)
+		return
+	}
+
+	events, err := repo.ListEventsForBook(r.Context(), id)
+	if err != nil {
+		http.Error(w, "Failed to fetch events", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(events)
+}
+
+func (h *BookHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	sinceParam := r.URL.Query().Get("since")
+	if sinceParam == "" {
+		http.Error(w, "since is required", http.StatusBadRequest)
+		return
+	}
+	since, err := time.Parse(time.RFC3339, sinceParam)
+	if err != nil {
+		http.Error(w, "since must be an RFC3339 timestamp", http.StatusBadRequest)
+		return
+	}
+
+	repo, ok := h.service.repo.(*BookRepositoryImpl)
+	if !ok {
+		http.Error(w, "Repository type assertion failed", http.StatusInternalServerError)
+		return
+	}
+
+	events, err := repo.ListEventsSince(r.Context(), since)
+	if err != nil {
+		http.Error(w, "Failed to fetch events", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(events)
+}
+
+// handler dispatches a request to one of its per-method funcs, returning
+// 405 for any method that isn't set. This replaces hand-checking r.Method
+// at the top of each BookHandler method.
+type handler struct {
+	get    http.HandlerFunc
+	post   http.HandlerFunc
+	put    http.HandlerFunc
+	delete http.HandlerFunc
+}
+
+func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var fn http.HandlerFunc
+	switch r.Method {
+	case http.MethodGet:
+		fn = h.get
+	case http.MethodPost:
+		fn = h.post
+	case http.MethodPut:
+		fn = h.put
+	case http.MethodDelete:
+		fn = h.delete
+	}
+	if fn == nil {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
 	}
+	fn(w, r)
 }
 
 // Add new handler methods to BookHandler
@@ -293,6 +549,16 @@ func (h *BookHandler) ReadyHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !isReady.Load() {
+		metrics.Ready.Set(0)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(HealthStatus{
+			Status:  "not ready",
+			Details: "startup checks not yet complete, or shutting down",
+		})
+		return
+	}
+
 	ctx := r.Context()
 	repo, ok := h.service.repo.(*BookRepositoryImpl)
 	if !ok {
@@ -302,6 +568,7 @@ func (h *BookHandler) ReadyHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Check database connectivity
 	if err := repo.CheckDBHealth(ctx); err != nil {
+		metrics.Ready.Set(0)
 		w.WriteHeader(http.StatusServiceUnavailable)
 		json.NewEncoder(w).Encode(HealthStatus{
 			Status:  "not ready",
@@ -311,7 +578,8 @@ func (h *BookHandler) ReadyHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Check Redis connectivity
-	if err := repo.CheckRedisHealth(ctx); err != nil {
+	if err := repo.CheckCacheHealth(ctx); err != nil {
+		metrics.Ready.Set(0)
 		w.WriteHeader(http.StatusServiceUnavailable)
 		json.NewEncoder(w).Encode(HealthStatus{
 			Status:  "not ready",
@@ -320,6 +588,7 @@ func (h *BookHandler) ReadyHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	metrics.Ready.Set(1)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(HealthStatus{Status: "ready"})
@@ -342,10 +611,10 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
-	defer db.Close()
 
 	// Check if running in init mode
 	if len(os.Args) > 1 && os.Args[1] == "init" {
+		defer db.Close()
 		if err := initDB(db); err != nil {
 			log.Fatalf("Failed to initialize database: %v", err)
 		}
@@ -353,24 +622,69 @@ func main() {
 		return
 	}
 
+	// Registered before the readiness wait below so a signal delivered
+	// while we're still blocked on a stuck dependency still runs the
+	// graceful-shutdown path instead of Go's default terminate-immediately
+	// disposition.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
 	// Setup dependencies
 	repo := NewBookRepository(db)
 	service := NewBookService(repo)
-	handler := NewBookHandler(service)
+	bookHandler := NewBookHandler(service)
 
 	// Setup routes
-	http.HandleFunc("/books", loggingMiddleware(handler.CreateBook))
-	http.HandleFunc("/books/", loggingMiddleware(handler.GetBook))
-	http.HandleFunc("/ready", loggingMiddleware(handler.ReadyHandler))
-	http.HandleFunc("/health", loggingMiddleware(handler.HealthHandler))
+	mux := http.NewServeMux()
+	mux.HandleFunc("/books", observabilityMiddleware("/books", handler{
+		get:  bookHandler.ListBooks,
+		post: bookHandler.CreateBook,
+	}.ServeHTTP))
+	mux.HandleFunc("/books/{id}", observabilityMiddleware("/books/{id}", handler{
+		get:    bookHandler.GetBook,
+		put:    bookHandler.UpdateBook,
+		delete: bookHandler.DeleteBook,
+	}.ServeHTTP))
+	mux.HandleFunc("/ready", observabilityMiddleware("/ready", bookHandler.ReadyHandler))
+	mux.HandleFunc("/health", observabilityMiddleware("/health", bookHandler.HealthHandler))
+	mux.HandleFunc("/books/{id}/events", observabilityMiddleware("/books/{id}/events", handler{get: bookHandler.ListBookEvents}.ServeHTTP))
+	mux.HandleFunc("/events", observabilityMiddleware("/events", handler{get: bookHandler.ListEvents}.ServeHTTP))
+	mux.Handle("/metrics", promhttp.Handler())
 
 	// Start server
 	server := &http.Server{
 		Addr:         ":8080",
+		Handler:      mux,
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 10 * time.Second,
 	}
 
+	// gRPC server, sharing the same service layer as the HTTP handlers
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "9090"
+	}
+	grpcListener, err := net.Listen("tcp", ":"+grpcPort)
+	if err != nil {
+		log.Fatalf("Failed to listen on gRPC port: %v", err)
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterBookServiceServer(grpcServer, newGRPCBookServer(service))
+	healthpb.RegisterHealthServer(grpcServer, newGRPCHealthServer(repo))
+
+	// Event dispatcher: polls the outbox and publishes to the event sink.
+	// dispatcherDone is waited on during shutdown so a dispatch in flight
+	// doesn't run its queries against a cache/DB we've already closed.
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+	dispatcher := NewEventDispatcher(repo, newEventSink())
+	var dispatcherDone sync.WaitGroup
+	dispatcherDone.Add(1)
+	go func() {
+		defer dispatcherDone.Done()
+		dispatcher.Run(dispatcherCtx)
+	}()
+
 	// Graceful shutdown
 	go func() {
 		log.Printf("Server starting on port 8080")
@@ -379,17 +693,89 @@ func main() {
 		}
 	}()
 
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	go func() {
+		log.Printf("gRPC server starting on port %s", grpcPort)
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			log.Fatalf("Failed to start gRPC server: %v", err)
+		}
+	}()
+
+	// Don't report ready until dependencies have responded at least once,
+	// so the pod isn't added to the load balancer before it can actually
+	// serve traffic. Race the wait against quit so a signal delivered
+	// while a dependency is stuck still reaches the shutdown path below.
+	ready := make(chan struct{})
+	go func() {
+		waitUntilReady(context.Background(), repo)
+		close(ready)
+	}()
+
+	select {
+	case <-ready:
+		isReady.Store(true)
+		log.Println("startup checks passed, now ready")
+		<-quit
+	case <-quit:
+		log.Println("received shutdown signal before startup checks passed")
+	}
+
+	// Flip not-ready first so load balancers and the Kubernetes endpoints
+	// controller stop routing new traffic, then give them PRESTOP_DELAY
+	// to notice before we start draining in-flight requests.
+	log.Println("Shutting down: marking not ready and waiting for traffic to drain")
+	isReady.Store(false)
+	time.Sleep(prestopDelay())
+
+	stopDispatcher()
+	dispatcherDone.Wait()
 
-	log.Println("Shutting down server...")
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	grpcServer.GracefulStop()
+
 	if err := server.Shutdown(ctx); err != nil {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
+
+	// Close dependencies in order: cache first, then the DB pool. By this
+	// point server.Shutdown/GracefulStop have drained HTTP/gRPC and
+	// dispatcherDone.Wait has confirmed the event dispatcher has exited,
+	// so nothing still holds a reference to either.
+	if err := repo.cache.Close(); err != nil {
+		log.Printf("Error closing cache client: %v", err)
+	}
+	if err := db.Close(); err != nil {
+		log.Printf("Error closing database pool: %v", err)
+	}
+}
+
+// waitUntilReady blocks until the DB and cache both respond to a health
+// check, so startup doesn't report ready before it can serve a request.
+func waitUntilReady(ctx context.Context, repo *BookRepositoryImpl) {
+	for {
+		if repo.CheckDBHealth(ctx) == nil && repo.CheckCacheHealth(ctx) == nil {
+			return
+		}
+		log.Println("waiting for database and cache to become reachable...")
+		time.Sleep(time.Second)
+	}
+}
+
+// prestopDelay reads PRESTOP_DELAY (a time.Duration string, e.g. "10s"),
+// defaulting to 10 seconds.
+func prestopDelay() time.Duration {
+	const defaultDelay = 10 * time.Second
+	v := os.Getenv("PRESTOP_DELAY")
+	if v == "" {
+		return defaultDelay
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		log.Printf("Invalid PRESTOP_DELAY %q, using default %v: %v", v, defaultDelay, err)
+		return defaultDelay
+	}
+	return d
 }
 
 func initDB(db *sql.DB) error {
@@ -400,6 +786,19 @@ func initDB(db *sql.DB) error {
             rating FLOAT CHECK (rating >= 0 AND rating <= 5),
             created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
         )`
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	query = `
+        CREATE TABLE IF NOT EXISTS book_events (
+            id BIGSERIAL PRIMARY KEY,
+            book_id TEXT NOT NULL,
+            event_type TEXT NOT NULL,
+            payload JSONB NOT NULL,
+            occurred_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+            published_at TIMESTAMP WITH TIME ZONE
+        )`
 	_, err := db.Exec(query)
 	return err
 }
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/nimbletactician/book-service/metrics"
+)
+
+func TestBookRepository_GetByID_NegativeCacheHit(t *testing.T) {
+	repo := &BookRepositoryImpl{cache: newLRUCache(defaultLRUCapacity), metrics: metrics.NewCache()}
+	ctx := context.Background()
+
+	repo.cache.Set(ctx, fmt.Sprintf("book:%s", "missing"), negativeCacheMarker, negativeTTL)
+
+	book, err := repo.GetByID(ctx, "missing")
+	if err != nil {
+		t.Fatalf("GetByID() error = %v, want nil", err)
+	}
+	if book != nil {
+		t.Fatalf("GetByID() = %+v, want nil (negative cache hit should never reach the DB)", book)
+	}
+}
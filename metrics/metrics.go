@@ -0,0 +1,61 @@
+// Package metrics holds the book-service's Prometheus collectors.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// HTTPRequestDuration is observed once per request, labeled by
+	// route, method, and status.
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "book_service_http_request_duration_seconds",
+		Help:    "HTTP request duration in seconds, by route, method, and status.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	// DBQueryDuration is observed around the repository's books-table
+	// queries (create, get_by_id, list, update, delete), labeled by
+	// operation. Event-table queries and transaction commits are not
+	// observed.
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "book_service_db_query_duration_seconds",
+		Help:    "Postgres query duration in seconds, by operation.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	// Ready tracks the current readiness state toggled by ReadyHandler.
+	Ready = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "book_service_ready",
+		Help: "1 if the service last reported ready, 0 otherwise.",
+	})
+)
+
+// Cache records cache hit/miss counts per repository operation
+// (get_by_id, list, ...).
+type Cache struct {
+	hits   *prometheus.CounterVec
+	misses *prometheus.CounterVec
+}
+
+func NewCache() Cache {
+	return Cache{
+		hits: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "book_service_cache_hits_total",
+			Help: "Cache hits, by repository operation.",
+		}, []string{"operation"}),
+		misses: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "book_service_cache_misses_total",
+			Help: "Cache misses, by repository operation.",
+		}, []string{"operation"}),
+	}
+}
+
+func (c Cache) Hit(operation string) {
+	c.hits.WithLabelValues(operation).Inc()
+}
+
+func (c Cache) Miss(operation string) {
+	c.misses.WithLabelValues(operation).Inc()
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nimbletactician/book-service/metrics"
+)
+
+type ctxKey int
+
+const (
+	requestIDCtxKey ctxKey = iota
+	latencyCtxKey
+)
+
+var requestIDSeq int64
+
+// nextRequestID generates a request id for requests that didn't arrive
+// with their own X-Request-Id header.
+func nextRequestID() string {
+	n := atomic.AddInt64(&requestIDSeq, 1)
+	return strconv.FormatInt(time.Now().UnixNano(), 36) + "-" + strconv.FormatInt(n, 36)
+}
+
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDCtxKey).(string)
+	return id
+}
+
+// latencyAccumulator collects per-request DB and cache time for the
+// logging middleware.
+type latencyAccumulator struct {
+	mu    sync.Mutex
+	db    time.Duration
+	cache time.Duration
+}
+
+func latencyFromContext(ctx context.Context) *latencyAccumulator {
+	acc, _ := ctx.Value(latencyCtxKey).(*latencyAccumulator)
+	return acc
+}
+
+func addDBLatency(ctx context.Context, d time.Duration) {
+	if acc := latencyFromContext(ctx); acc != nil {
+		acc.mu.Lock()
+		acc.db += d
+		acc.mu.Unlock()
+	}
+}
+
+func addCacheLatency(ctx context.Context, d time.Duration) {
+	if acc := latencyFromContext(ctx); acc != nil {
+		acc.mu.Lock()
+		acc.cache += d
+		acc.mu.Unlock()
+	}
+}
+
+// observeDBQuery records a DB round trip against the duration histogram
+// and the request's latency accumulator.
+func observeDBQuery(ctx context.Context, operation string, start time.Time) {
+	d := time.Since(start)
+	metrics.DBQueryDuration.WithLabelValues(operation).Observe(d.Seconds())
+	addDBLatency(ctx, d)
+}
+
+// statusRecorder wraps http.ResponseWriter to capture the status code and
+// byte count written.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (w *statusRecorder) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusRecorder) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+// observabilityMiddleware logs each request and records its Prometheus
+// histogram. route is the registered mux pattern, not r.URL.Path, so
+// path parameters like {id} don't blow up metric cardinality.
+func observabilityMiddleware(route string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get("X-Request-Id")
+		if requestID == "" {
+			requestID = nextRequestID()
+		}
+
+		w.Header().Set("X-Request-Id", requestID)
+
+		acc := &latencyAccumulator{}
+		ctx := context.WithValue(r.Context(), requestIDCtxKey, requestID)
+		ctx = context.WithValue(ctx, latencyCtxKey, acc)
+		r = r.WithContext(ctx)
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next(rec, r)
+		duration := time.Since(start)
+
+		metrics.HTTPRequestDuration.
+			WithLabelValues(route, r.Method, strconv.Itoa(rec.status)).
+			Observe(duration.Seconds())
+
+		acc.mu.Lock()
+		dbLatency, cacheLatency := acc.db, acc.cache
+		acc.mu.Unlock()
+
+		slog.Info("request completed",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"duration_ms", duration.Milliseconds(),
+			"bytes", rec.bytes,
+			"request_id", requestID,
+			"db_latency_ms", dbLatency.Milliseconds(),
+			"cache_latency_ms", cacheLatency.Milliseconds(),
+		)
+	}
+}